@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+const jaegerBaggageHeader = "jaeger-baggage"
+
+// JaegerBaggagePropagator bridges the free-form "jaeger-baggage" header
+// sent by legacy Jaeger clients into OTel baggage. Unlike UberTraceContext,
+// jaeger-baggage carries no trace context of its own: it is a flat
+// "k1=v1,k2=v2" list that legacy clients attach to every request whether or
+// not a trace is active. See:
+// https://www.jaegertracing.io/docs/1.40/client-libraries/#baggage
+type JaegerBaggagePropagator struct{}
+
+var _ propagation.TextMapPropagator = JaegerBaggagePropagator{}
+
+// Extract parses the comma-separated k=v pairs in jaeger-baggage and merges
+// them into any baggage.Baggage already carried by ctx, even if ctx has no
+// active SpanContext (e.g. no uber-trace-id header was present).
+func (p JaegerBaggagePropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	h := carrier.Get(jaegerBaggageHeader)
+	if h == "" {
+		return ctx
+	}
+
+	bag := baggage.FromContext(ctx)
+	for _, pair := range strings.Split(h, ",") {
+		k, v, found := strings.Cut(pair, "=")
+		if !found {
+			continue
+		}
+		k = strings.TrimSpace(k)
+		if k == "" {
+			continue
+		}
+		v, err := url.QueryUnescape(strings.TrimSpace(v))
+		if err != nil {
+			continue
+		}
+		member, err := baggage.NewMemberRaw(k, v)
+		if err != nil {
+			continue
+		}
+		if bag, err = bag.SetMember(member); err != nil {
+			continue
+		}
+	}
+
+	return baggage.ContextWithBaggage(ctx, bag)
+}
+
+// Inject is a no-op: the W3C baggage propagator handles egress for the
+// baggage collected by Extract.
+func (p JaegerBaggagePropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+}
+
+// Fields returns the keys whose values are read by Extract.
+func (p JaegerBaggagePropagator) Fields() []string {
+	return []string{jaegerBaggageHeader}
+}
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestJaegerBaggagePropagatorExtraction(t *testing.T) {
+	h := http.Header{}
+	h.Add(jaegerBaggageHeader, "k1=v1, k2=jean-luc%20picard")
+
+	p := JaegerBaggagePropagator{}
+	ctx := p.Extract(context.Background(), propagation.HeaderCarrier(h))
+
+	bag := baggage.FromContext(ctx)
+	assertString(t, "v1", bag.Member("k1").Value())
+	assertString(t, "jean-luc picard", bag.Member("k2").Value())
+}
+
+func TestJaegerBaggagePropagatorMergesExistingBaggage(t *testing.T) {
+	existing, err := baggage.NewMemberRaw("existing", "yes")
+	if err != nil {
+		t.Fatalf("baggage.NewMemberRaw: %s", err)
+	}
+	bag, err := baggage.New(existing)
+	if err != nil {
+		t.Fatalf("baggage.New: %s", err)
+	}
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	h := http.Header{}
+	h.Add(jaegerBaggageHeader, "k1=v1")
+
+	ctx = JaegerBaggagePropagator{}.Extract(ctx, propagation.HeaderCarrier(h))
+
+	got := baggage.FromContext(ctx)
+	assertString(t, "yes", got.Member("existing").Value())
+	assertString(t, "v1", got.Member("k1").Value())
+}
+
+func TestJaegerBaggagePropagatorInjectIsNoop(t *testing.T) {
+	h := http.Header{}
+	JaegerBaggagePropagator{}.Inject(context.Background(), propagation.HeaderCarrier(h))
+	assertString(t, "", h.Get(jaegerBaggageHeader))
+}
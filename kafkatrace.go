@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// StartProduceSpan starts a producer span for msg, injects the span's
+// context into msg's headers via kafkaCarrier so a consumer can link back to
+// it, and returns ctx holding the span alongside the span itself. Callers
+// must End the returned span once the write completes, recording any
+// delivery error on it first.
+func StartProduceSpan(ctx context.Context, tracer trace.Tracer, topic string, msg *kafka.Message) (context.Context, trace.Span) {
+	ctx, span := tracer.Start(ctx, topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(topic),
+			semconv.MessagingOperationPublish,
+		),
+	)
+
+	carrier := NewKafkaCarrier(msg.Headers)
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	msg.Headers = carrier.Headers()
+
+	return ctx, span
+}
+
+// StartConsumeSpan extracts the producer's trace context from msg's headers
+// via kafkaCarrier and starts a consumer span as its child. Callers must End
+// the returned span once handling of msg is complete.
+func StartConsumeSpan(ctx context.Context, tracer trace.Tracer, msg *kafka.Message) (context.Context, trace.Span) {
+	ctx = otel.GetTextMapPropagator().Extract(ctx, NewKafkaCarrier(msg.Headers))
+
+	return tracer.Start(ctx, msg.Topic+" receive",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(msg.Topic),
+			semconv.MessagingKafkaDestinationPartition(msg.Partition),
+			semconv.MessagingKafkaMessageOffset(int(msg.Offset)),
+			semconv.MessagingOperationReceive,
+		),
+	)
+}
+
+// KafkaTraceHooks implements kgo.Hook interfaces to trace franz-go produced
+// and consumed records, mirroring StartProduceSpan and StartConsumeSpan for
+// segmentio's kafka-go. Register it with kgo.WithHooks when constructing the
+// client.
+type KafkaTraceHooks struct {
+	Tracer trace.Tracer
+}
+
+var (
+	_ kgo.HookProduceRecordBuffered   = KafkaTraceHooks{}
+	_ kgo.HookProduceRecordUnbuffered = KafkaTraceHooks{}
+	_ kgo.HookFetchRecordBuffered     = KafkaTraceHooks{}
+)
+
+type kafkaSpanKey struct{}
+
+// OnProduceRecordBuffered starts a producer span for r, injects it into r's
+// headers via franzKafkaCarrier so a consumer can link back to it, and
+// stashes the span in r.Context for OnProduceRecordUnbuffered to end.
+func (h KafkaTraceHooks) OnProduceRecordBuffered(r *kgo.Record) {
+	ctx := r.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, span := h.Tracer.Start(ctx, r.Topic+" publish",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(r.Topic),
+			semconv.MessagingOperationPublish,
+		),
+	)
+
+	carrier := NewFranzKafkaCarrier(r.Headers)
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	r.Headers = carrier.Headers()
+
+	r.Context = context.WithValue(ctx, kafkaSpanKey{}, span)
+}
+
+// OnProduceRecordUnbuffered ends the span started by OnProduceRecordBuffered,
+// recording err and setting the status to codes.Error on delivery failure,
+// and otherwise recording the partition and offset the broker assigned r.
+func (h KafkaTraceHooks) OnProduceRecordUnbuffered(r *kgo.Record, err error) {
+	span, ok := r.Context.Value(kafkaSpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+	defer span.End()
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return
+	}
+	span.SetAttributes(
+		semconv.MessagingKafkaDestinationPartition(int(r.Partition)),
+		semconv.MessagingKafkaMessageOffset(int(r.Offset)),
+	)
+}
+
+// OnFetchRecordBuffered starts and immediately ends a consumer span for r,
+// extracting the producer's trace context from r's headers via
+// franzKafkaCarrier so the spans link together.
+func (h KafkaTraceHooks) OnFetchRecordBuffered(r *kgo.Record) {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), NewFranzKafkaCarrier(r.Headers))
+
+	_, span := h.Tracer.Start(ctx, r.Topic+" receive",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			semconv.MessagingSystemKafka,
+			semconv.MessagingDestinationName(r.Topic),
+			semconv.MessagingKafkaDestinationPartition(int(r.Partition)),
+			semconv.MessagingKafkaMessageOffset(int(r.Offset)),
+			semconv.MessagingOperationReceive,
+		),
+	)
+	span.End()
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func newRecordingTracer(t *testing.T) (trace.Tracer, *tracetest.SpanRecorder) {
+	rec := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(rec))
+	t.Cleanup(func() { _ = tp.Shutdown(context.Background()) })
+	return tp.Tracer("kafkatrace_test"), rec
+}
+
+func TestStartProduceConsumeSpanLinksViaHeaders(t *testing.T) {
+	prevPropagator := otel.GetTextMapPropagator()
+	otel.SetTextMapPropagator(defaultPropagator())
+	t.Cleanup(func() { otel.SetTextMapPropagator(prevPropagator) })
+
+	tracer, rec := newRecordingTracer(t)
+
+	msg := &kafka.Message{Topic: "orders", Partition: 1, Offset: 42}
+	_, produceSpan := StartProduceSpan(context.Background(), tracer, msg.Topic, msg)
+	produceSpan.End()
+
+	if len(msg.Headers) == 0 {
+		t.Fatalf("expected StartProduceSpan to inject trace headers into msg.Headers")
+	}
+
+	_, consumeSpan := StartConsumeSpan(context.Background(), tracer, msg)
+	consumeSpan.End()
+
+	ended := rec.Ended()
+	if len(ended) != 2 {
+		t.Fatalf("expected 2 ended spans, got %d", len(ended))
+	}
+	if ended[1].Parent().SpanID() != ended[0].SpanContext().SpanID() {
+		t.Fatalf("expected consume span to be a child of the produce span")
+	}
+}
+
+func TestKafkaTraceHooksProduceRecordsError(t *testing.T) {
+	tracer, rec := newRecordingTracer(t)
+	h := KafkaTraceHooks{Tracer: tracer}
+
+	r := &kgo.Record{Topic: "orders"}
+	h.OnProduceRecordBuffered(r)
+	h.OnProduceRecordUnbuffered(r, errors.New("broker unavailable"))
+
+	ended := rec.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected 1 ended span, got %d", len(ended))
+	}
+	if ended[0].Status().Code != codes.Error {
+		t.Fatalf("expected span status to be Error, got %v", ended[0].Status().Code)
+	}
+}
+
+func TestKafkaTraceHooksFetchRecordBuffered(t *testing.T) {
+	tracer, rec := newRecordingTracer(t)
+	h := KafkaTraceHooks{Tracer: tracer}
+
+	h.OnFetchRecordBuffered(&kgo.Record{Topic: "orders", Partition: 2, Offset: 7})
+
+	ended := rec.Ended()
+	if len(ended) != 1 {
+		t.Fatalf("expected 1 ended span, got %d", len(ended))
+	}
+}
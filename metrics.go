@@ -0,0 +1,184 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"time"
+
+	runtimeinstr "go.opentelemetry.io/contrib/instrumentation/runtime"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/prometheus"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+type metricsSetupConfig struct {
+	envGate         bool
+	shutdownTimeout time.Duration
+	logger          *slog.Logger
+	readers         []sdkmetric.Reader
+	runtimeMetrics  bool
+}
+
+type MetricsSetupOptionFunc func(*metricsSetupConfig)
+
+// WithMetricsEnvGate causes a call to OtelMetricsSetup to be a no-op if the
+// environment variable defined by EnvGateName is set to the value defined
+// by EnvGateCue, mirroring WithEnvGate for OtelSetup.
+func WithMetricsEnvGate() MetricsSetupOptionFunc {
+	return MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+		opts.envGate = true
+	})
+}
+
+// WithMetricsLogger configures the given logger to be used for printing
+// errors or info at runtime emitted by the metrics implementation. If
+// unset, a default value of slog.Default() will be used.
+func WithMetricsLogger(logger *slog.Logger) MetricsSetupOptionFunc {
+	return MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+		opts.logger = logger
+	})
+}
+
+// WithMetricsShutdownTimeout limits the amount of time that the close
+// function returned by OtelMetricsSetup may wait.
+func WithMetricsShutdownTimeout(t time.Duration) MetricsSetupOptionFunc {
+	return MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+		opts.shutdownTimeout = t
+	})
+}
+
+// WithOtlpMetricExporter causes OtelMetricsSetup to configure a
+// PeriodicReader pushing to the otlp metrics endpoint. The interval between
+// exports honors the standard OTEL_METRIC_EXPORT_INTERVAL environment
+// variable.
+func WithOtlpMetricExporter() MetricsSetupOptionFunc {
+	return MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+		exporter, err := otlpmetricgrpc.New(context.Background())
+		if err != nil {
+			panic(fmt.Sprintf("cannot create otlp metric exporter: %s", err))
+		}
+
+		opts.readers = append(opts.readers, sdkmetric.NewPeriodicReader(exporter))
+	})
+}
+
+// WithPrometheusExporter causes OtelMetricsSetup to configure a Prometheus
+// exporter. Unlike the push-based exporters, it is scraped directly and
+// registers itself with the default Prometheus registry; pair it with
+// promhttp.Handler on a metrics endpoint.
+func WithPrometheusExporter() MetricsSetupOptionFunc {
+	return MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+		exporter, err := prometheus.New()
+		if err != nil {
+			panic(fmt.Sprintf("cannot create prometheus exporter: %s", err))
+		}
+
+		opts.readers = append(opts.readers, exporter)
+	})
+}
+
+// WithRuntimeMetrics causes OtelMetricsSetup to register the Go runtime
+// instrumentation (runtime.uptime, process.runtime.go.mem.heap_alloc, GC
+// pause histograms, and friends) against the configured MeterProvider.
+func WithRuntimeMetrics() MetricsSetupOptionFunc {
+	return MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+		opts.runtimeMetrics = true
+	})
+}
+
+// JoinClosers returns a closerFunc that closes every one of closers in
+// order, joining any errors they return. Use it to flush the
+// TracerProvider and MeterProvider returned by OtelSetup and
+// OtelMetricsSetup together.
+func JoinClosers(closers ...closerFunc) closerFunc {
+	return closerFunc(func() error {
+		var errs []error
+		for _, c := range closers {
+			if c == nil {
+				continue
+			}
+			if err := c(); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
+
+// OtelMetricsSetup returns an otel MeterProvider and a closer function to
+// shut it down, mirroring OtelSetup for the metrics signal. It builds its
+// Resource the same way OtelSetup does (service.name, host.name, host.ip)
+// so traces and metrics for name correlate, and honors OTEL_SDK_DISABLED
+// via WithMetricsEnvGate just like WithEnvGate does for OtelSetup. Combine
+// its closer with OtelSetup's via JoinClosers to shut both providers down
+// together.
+func OtelMetricsSetup(ctx context.Context, name string, with ...MetricsSetupOptionFunc) (
+	mp *sdkmetric.MeterProvider, closer closerFunc, err error,
+) {
+	// Always return working no-ops instead of nils
+	defer func() {
+		if mp == nil {
+			mp = sdkmetric.NewMeterProvider()
+		}
+		if closer == nil {
+			closer = closerFunc(func() error { return nil })
+		}
+	}()
+
+	// Apply options; if one panics, log it and return the no-op provider
+	opts := &metricsSetupConfig{
+		logger: slog.Default(),
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			opts.logger.ErrorContext(ctx, "panic occurred in OtelMetricsSetup", "error", r)
+		}
+	}()
+	for _, fn := range with {
+		fn(opts)
+	}
+
+	if opts.envGate && os.Getenv(EnvGateName) == EnvGateCue {
+		return
+	}
+
+	mpOpts := []sdkmetric.Option{
+		sdkmetric.WithResource(buildResource(ctx, name, opts.logger)),
+	}
+	for _, reader := range opts.readers {
+		mpOpts = append(mpOpts, sdkmetric.WithReader(reader))
+	}
+
+	mp = sdkmetric.NewMeterProvider(mpOpts...)
+
+	if opts.runtimeMetrics {
+		if err := runtimeinstr.Start(runtimeinstr.WithMeterProvider(mp)); err != nil {
+			opts.logger.ErrorContext(ctx, "failed to start Go runtime metrics", "error", err)
+		}
+	}
+
+	closer = closerFunc(func() error {
+		var ctx context.Context
+		var cancel context.CancelFunc
+		if opts.shutdownTimeout > 0 {
+			ctx, cancel = context.WithTimeout(
+				context.Background(), opts.shutdownTimeout)
+			defer cancel()
+		} else {
+			ctx = context.Background()
+		}
+		err := mp.Shutdown(ctx)
+		if err != nil {
+			opts.logger.ErrorContext(ctx, "otel metrics shutdown error", "error", err)
+		}
+		return err
+	})
+
+	return
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+func TestOtelMetricsSetupUsesSharedResource(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+
+	mp, closer, err := OtelMetricsSetup(context.Background(), "test-service",
+		MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+			opts.readers = append(opts.readers, reader)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("OtelMetricsSetup: %s", err)
+	}
+	defer closer()
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("Collect: %s", err)
+	}
+
+	got, ok := rm.Resource.Set().Value("service.name")
+	if !ok || got.AsString() != "test-service" {
+		t.Fatalf("expected resource to carry service.name=test-service, got %v", rm.Resource)
+	}
+
+	_ = mp
+}
+
+func TestOtelMetricsSetupEnvGate(t *testing.T) {
+	t.Setenv(EnvGateName, EnvGateCue)
+
+	reader := sdkmetric.NewManualReader()
+	_, _, err := OtelMetricsSetup(context.Background(), "test-service",
+		WithMetricsEnvGate(),
+		MetricsSetupOptionFunc(func(opts *metricsSetupConfig) {
+			opts.readers = append(opts.readers, reader)
+		}),
+	)
+	if err != nil {
+		t.Fatalf("OtelMetricsSetup: %s", err)
+	}
+
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err == nil {
+		t.Fatalf("expected the reader to never have been registered when %s is set", EnvGateName)
+	}
+}
+
+func TestJoinClosers(t *testing.T) {
+	errA := errors.New("a failed")
+	errB := errors.New("b failed")
+
+	err := JoinClosers(
+		closerFunc(func() error { return errA }),
+		closerFunc(func() error { return nil }),
+		closerFunc(func() error { return errB }),
+	)()
+
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Fatalf("expected joined error to contain both errors, got %v", err)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	otTraceIDHeader = "ot-tracer-traceid"
+	otSpanIDHeader  = "ot-tracer-spanid"
+	otSampledHeader = "ot-tracer-sampled"
+	otBaggagePrefix = "ot-baggage-"
+)
+
+// OTPropagator is a propagator for the "OpenTracing basictracer" header
+// format: ot-tracer-traceid, ot-tracer-spanid, ot-tracer-sampled, plus an
+// ot-baggage-<key> header per baggage member. See:
+// https://github.com/opentracing/basictracer-go
+//
+// OTPropagator operates on the same principle as UberTraceContext: it lets
+// services that still speak to legacy OpenTracing peers stay interoperable
+// without forcing every caller onto the W3C trace context format.
+type OTPropagator struct{}
+
+var _ propagation.TextMapPropagator = OTPropagator{}
+
+var otBaggageTokenRegExp = regexp.MustCompile(`^[!#$%&'*+\-.^_` + "`" + `|~0-9A-Za-z]+$`)
+
+// Inject sets the ot-tracer-* headers from the Context into the carrier,
+// along with an ot-baggage-<key> header per baggage member carried by ctx.
+func (o OTPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.IsValid() {
+		traceID := sc.TraceID().String()
+		if len(traceID) > 16 {
+			traceID = traceID[len(traceID)-16:]
+		}
+		carrier.Set(otTraceIDHeader, traceID)
+		carrier.Set(otSpanIDHeader, sc.SpanID().String())
+		if sc.IsSampled() {
+			carrier.Set(otSampledHeader, "true")
+		} else {
+			carrier.Set(otSampledHeader, "false")
+		}
+	}
+
+	for _, m := range baggage.FromContext(ctx).Members() {
+		carrier.Set(otBaggagePrefix+m.Key(), escapeOTBaggageValue(m.Value()))
+	}
+}
+
+// Extract reads the ot-tracer-* headers and any ot-baggage-* headers from
+// the carrier into a returned Context.
+//
+// The returned Context will be a copy of ctx and contain the extracted
+// ot-tracer-* headers as the remote SpanContext, and any ot-baggage-*
+// headers merged in as OTel baggage, even if the ot-tracer-* headers are
+// absent or invalid.
+func (o OTPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	if sc := o.extract(carrier); sc.IsValid() {
+		ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+	}
+
+	if bag, ok := mergeOTBaggage(ctx, carrier); ok {
+		ctx = baggage.ContextWithBaggage(ctx, bag)
+	}
+
+	return ctx
+}
+
+func (o OTPropagator) extract(carrier propagation.TextMapCarrier) trace.SpanContext {
+	traceID := carrier.Get(otTraceIDHeader)
+	spanID := carrier.Get(otSpanIDHeader)
+	if traceID == "" || spanID == "" {
+		return trace.SpanContext{}
+	}
+
+	var scc trace.SpanContextConfig
+
+	tid, err := decodeHexID(traceID, 16) // 128 bits, left-padded from 64 if needed
+	if err != nil {
+		return trace.SpanContext{}
+	}
+	copy(scc.TraceID[:], tid)
+
+	sid, err := decodeHexID(spanID, 8) // 64 bits
+	if err != nil {
+		return trace.SpanContext{}
+	}
+	copy(scc.SpanID[:], sid)
+
+	switch strings.ToLower(carrier.Get(otSampledHeader)) {
+	case "true", "1":
+		scc.TraceFlags = trace.FlagsSampled
+	}
+
+	return trace.NewSpanContext(scc)
+}
+
+// mergeOTBaggage collects every carrier header whose lowercase key starts
+// with ot-baggage- and merges them into any baggage.Baggage already
+// carried by ctx, the same way JaegerBaggagePropagator.Extract does.
+func mergeOTBaggage(ctx context.Context, carrier propagation.TextMapCarrier) (baggage.Baggage, bool) {
+	bag := baggage.FromContext(ctx)
+	found := false
+	for _, key := range carrier.Keys() {
+		lowerKey := strings.ToLower(key)
+		if !strings.HasPrefix(lowerKey, otBaggagePrefix) {
+			continue
+		}
+		name := lowerKey[len(otBaggagePrefix):]
+		if name == "" {
+			continue
+		}
+		value, err := url.PathUnescape(carrier.Get(key))
+		if err != nil {
+			continue
+		}
+		member, err := baggage.NewMemberRaw(name, value)
+		if err != nil {
+			continue
+		}
+		if bag, err = bag.SetMember(member); err != nil {
+			continue
+		}
+		found = true
+	}
+
+	return bag, found
+}
+
+// escapeOTBaggageValue URL-escapes v unless it is already a valid HTTP
+// token, matching the set of characters baggage values can safely carry
+// in a raw header value.
+func escapeOTBaggageValue(v string) string {
+	if otBaggageTokenRegExp.MatchString(v) {
+		return v
+	}
+	return url.PathEscape(v)
+}
+
+// Fields returns the fixed keys whose values are set with Inject.
+// ot-baggage-* keys are dynamic and therefore not listed.
+func (o OTPropagator) Fields() []string {
+	return []string{otTraceIDHeader, otSpanIDHeader, otSampledHeader}
+}
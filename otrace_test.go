@@ -0,0 +1,109 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestOTPropagatorExtraction(t *testing.T) {
+	h := http.Header{}
+	h.Add(otTraceIDHeader, "5c301b3cb0f66539")
+	h.Add(otSpanIDHeader, "114d5e5bcc8bc4c8")
+	h.Add(otSampledHeader, "true")
+	h.Add(otBaggagePrefix+"name", "jean-luc%20picard")
+
+	o := OTPropagator{}
+	ctx := o.Extract(context.Background(), propagation.HeaderCarrier(h))
+
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		t.Fatalf("span context was not valid")
+	}
+	assertString(t, "00000000000000005c301b3cb0f66539", sc.TraceID().String())
+	assertString(t, "114d5e5bcc8bc4c8", sc.SpanID().String())
+	if sc.TraceFlags() != trace.FlagsSampled {
+		t.Fatalf("expected sampled flag to be set")
+	}
+
+	member := baggage.FromContext(ctx).Member("name")
+	assertString(t, "jean-luc picard", member.Value())
+}
+
+func TestOTPropagatorInject(t *testing.T) {
+	scc := trace.SpanContextConfig{
+		TraceID: trace.TraceID{
+			0, 0, 0, 0, 0, 0, 0, 0,
+			0x5c, 0x30, 0x1b, 0x3c, 0xb0, 0xf6, 0x65, 0x39,
+		},
+		SpanID:     trace.SpanID{0x11, 0x4d, 0x5e, 0x5b, 0xcc, 0x8b, 0xc4, 0xc8},
+		TraceFlags: trace.FlagsSampled,
+	}
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(scc))
+
+	member, err := baggage.NewMemberRaw("name", "jean-luc picard")
+	if err != nil {
+		t.Fatalf("baggage.NewMemberRaw: %s", err)
+	}
+	bag, err := baggage.New(member)
+	if err != nil {
+		t.Fatalf("baggage.New: %s", err)
+	}
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+
+	h := http.Header{}
+	OTPropagator{}.Inject(ctx, propagation.HeaderCarrier(h))
+
+	assertString(t, "5c301b3cb0f66539", h.Get(otTraceIDHeader))
+	assertString(t, "114d5e5bcc8bc4c8", h.Get(otSpanIDHeader))
+	assertString(t, "true", h.Get(otSampledHeader))
+	assertString(t, "jean-luc%20picard", h.Get(otBaggagePrefix+"name"))
+}
+
+func TestOTPropagatorExtractionMergesExistingBaggage(t *testing.T) {
+	member, err := baggage.NewMemberRaw("w3ckey", "w3cval")
+	if err != nil {
+		t.Fatalf("baggage.NewMemberRaw: %s", err)
+	}
+	bag, err := baggage.New(member)
+	if err != nil {
+		t.Fatalf("baggage.New: %s", err)
+	}
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	h := http.Header{}
+	h.Add(otBaggagePrefix+"otkey", "otval")
+
+	o := OTPropagator{}
+	ctx = o.Extract(ctx, propagation.HeaderCarrier(h))
+
+	got := baggage.FromContext(ctx)
+	assertString(t, "w3cval", got.Member("w3ckey").Value())
+	assertString(t, "otval", got.Member("otkey").Value())
+}
+
+func TestOTPropagatorBaggagePlusValueRoundTrips(t *testing.T) {
+	member, err := baggage.NewMemberRaw("lang", "c++lang")
+	if err != nil {
+		t.Fatalf("baggage.NewMemberRaw: %s", err)
+	}
+	bag, err := baggage.New(member)
+	if err != nil {
+		t.Fatalf("baggage.New: %s", err)
+	}
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+
+	h := http.Header{}
+	OTPropagator{}.Inject(ctx, propagation.HeaderCarrier(h))
+
+	extracted := OTPropagator{}.Extract(context.Background(), propagation.HeaderCarrier(h))
+	got := baggage.FromContext(extracted).Member("lang")
+	assertString(t, "c++lang", got.Value())
+}
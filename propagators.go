@@ -0,0 +1,114 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/contrib/propagators/aws/xray"
+	"go.opentelemetry.io/contrib/propagators/b3"
+	"go.opentelemetry.io/contrib/propagators/jaeger"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// EnvPropagators is the standard OTel environment variable naming the
+// comma-separated list of propagators to compose, used by
+// WithGeneralPropagatorSetup and WithEnvPropagators. See:
+// https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/#general-sdk-configuration
+const EnvPropagators = "OTEL_PROPAGATORS"
+
+var (
+	propagatorRegistryMu sync.RWMutex
+
+	// propagatorRegistry maps OTEL_PROPAGATORS names to propagators. It is
+	// seeded with the names defined by the OTel spec plus this repo's own
+	// "uber" propagator, and can be extended with RegisterPropagator.
+	propagatorRegistry = map[string]propagation.TextMapPropagator{
+		"tracecontext": propagation.TraceContext{},
+		"baggage":      propagation.Baggage{},
+		"b3":           b3.New(b3.WithInjectEncoding(b3.B3SingleHeader)),
+		"b3multi":      b3.New(b3.WithInjectEncoding(b3.B3MultipleHeader)),
+		"jaeger":       jaeger.Jaeger{},
+		"xray":         xray.Propagator{},
+		"ottrace":      OTPropagator{},
+		"uber":         UberTraceContext{},
+	}
+)
+
+// RegisterPropagator makes p selectable by name from the OTEL_PROPAGATORS
+// environment variable, for use with WithGeneralPropagatorSetup and
+// WithEnvPropagators. It may also be used to override one of the built-in
+// names. RegisterPropagator is safe to call concurrently with OtelSetup; a
+// name registered after a propagator has been built does not change it.
+func RegisterPropagator(name string, p propagation.TextMapPropagator) {
+	propagatorRegistryMu.Lock()
+	defer propagatorRegistryMu.Unlock()
+	propagatorRegistry[name] = p
+}
+
+func lookupPropagator(name string) (propagation.TextMapPropagator, bool) {
+	propagatorRegistryMu.RLock()
+	defer propagatorRegistryMu.RUnlock()
+	p, ok := propagatorRegistry[name]
+	return p, ok
+}
+
+// defaultPropagator is the composite propagator used when OTEL_PROPAGATORS
+// is unset. OTPropagator is placed before TraceContext and UberTraceContext
+// so that, on Extract, their full 128-bit trace IDs override its 64-bit one.
+func defaultPropagator() propagation.TextMapPropagator {
+	return propagation.NewCompositeTextMapPropagator(
+		propagation.Baggage{},
+		OTPropagator{},
+		propagation.TraceContext{},
+		UberTraceContext{},
+		JaegerBaggagePropagator{},
+	)
+}
+
+// envOrDefaultPropagator builds a composite propagator from the
+// comma-separated names in OTEL_PROPAGATORS, looking each one up in
+// propagatorRegistry. Unknown names are logged and skipped. If the
+// environment variable is unset, or no valid names are found in it,
+// fallback is returned instead.
+func envOrDefaultPropagator(logger *slog.Logger, fallback propagation.TextMapPropagator) propagation.TextMapPropagator {
+	raw := os.Getenv(EnvPropagators)
+	if raw == "" {
+		return fallback
+	}
+
+	var propagators []propagation.TextMapPropagator
+	for _, name := range strings.Split(raw, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		p, ok := lookupPropagator(name)
+		if !ok {
+			logger.Error("unknown propagator name in "+EnvPropagators, "name", name)
+			continue
+		}
+		propagators = append(propagators, p)
+	}
+
+	if len(propagators) == 0 {
+		return fallback
+	}
+	return propagation.NewCompositeTextMapPropagator(propagators...)
+}
+
+// WithEnvPropagators causes OtelSetup to configure the composite propagator
+// from OTEL_PROPAGATORS, matching the OTel spec. It supports the standard
+// names "tracecontext", "baggage", "b3", "b3multi", "jaeger", "xray" and
+// "ottrace", plus this repo's own "uber" propagator; RegisterPropagator can
+// add further names. If OTEL_PROPAGATORS is unset, it behaves the same as
+// WithGeneralPropagatorSetup.
+func WithEnvPropagators() SetupOptionFunc {
+	return SetupOptionFunc(func(opts *setupConfig) {
+		opts.propagator = envOrDefaultPropagator(opts.logger, defaultPropagator())
+	})
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"log/slog"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+func TestEnvOrDefaultPropagatorUnset(t *testing.T) {
+	t.Setenv(EnvPropagators, "")
+
+	fallback := propagation.Baggage{}
+	p := envOrDefaultPropagator(slog.Default(), fallback)
+	if p != propagation.TextMapPropagator(fallback) {
+		t.Fatalf("expected fallback propagator when %s is unset", EnvPropagators)
+	}
+}
+
+func TestEnvOrDefaultPropagatorSelectsRegistered(t *testing.T) {
+	t.Setenv(EnvPropagators, "tracecontext, uber")
+
+	p := envOrDefaultPropagator(slog.Default(), defaultPropagator())
+
+	fields := p.Fields()
+	assertContains(t, fields, "traceparent")
+	assertContains(t, fields, uberHeader)
+	for _, f := range fields {
+		if f == "baggage" {
+			t.Fatalf("baggage should not have been selected, got fields %v", fields)
+		}
+	}
+}
+
+func TestEnvOrDefaultPropagatorUnknownName(t *testing.T) {
+	t.Setenv(EnvPropagators, "bogus, uber")
+
+	p := envOrDefaultPropagator(slog.Default(), defaultPropagator())
+	assertContains(t, p.Fields(), uberHeader)
+}
+
+func TestRegisterPropagator(t *testing.T) {
+	RegisterPropagator("test-noop", propagation.Baggage{})
+	defer func() {
+		propagatorRegistryMu.Lock()
+		delete(propagatorRegistry, "test-noop")
+		propagatorRegistryMu.Unlock()
+	}()
+
+	p, ok := lookupPropagator("test-noop")
+	if !ok {
+		t.Fatalf("expected registered propagator to be found")
+	}
+	if p != propagation.TextMapPropagator(propagation.Baggage{}) {
+		t.Fatalf("expected the registered propagator back")
+	}
+}
+
+func assertContains(t *testing.T, haystack []string, needle string) {
+	t.Helper()
+	for _, s := range haystack {
+		if s == needle {
+			return
+		}
+	}
+	t.Fatalf("expected %q in %v", needle, haystack)
+}
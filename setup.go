@@ -2,6 +2,7 @@ package otel
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"log/slog"
@@ -15,18 +16,27 @@ import (
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
 	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/sdk/resource"
 	trace "go.opentelemetry.io/otel/sdk/trace"
-	semconv "go.opentelemetry.io/otel/semconv/v1.32.0"
+	semconv "go.opentelemetry.io/otel/semconv/v1.25.0"
 )
 
+// EnvTracesExporter is the standard OTel environment variable naming the
+// comma-separated list of exporters to fan traces out to when no
+// WithExporter* option is passed. See:
+// https://opentelemetry.io/docs/specs/otel/configuration/sdk-environment-variables/#exporter-selection
+const EnvTracesExporter = "OTEL_TRACES_EXPORTER"
+
 type setupConfig struct {
 	name            string
 	envGate         bool
 	shutdownTimeout time.Duration
 	logger          *slog.Logger
-	exporter        trace.SpanExporter
+	exporters       []trace.SpanExporter
+	exportersSet    bool
 	sampler         trace.Sampler
 	propagator      propagation.TextMapPropagator
 }
@@ -60,6 +70,19 @@ func (n nullExporter) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+// shutdownErrExporter wraps a trace.SpanExporter to record the error from
+// its Shutdown, since BatchSpanProcessor.Shutdown only reports that error to
+// otel.Handle and never returns it.
+type shutdownErrExporter struct {
+	trace.SpanExporter
+	err error
+}
+
+func (e *shutdownErrExporter) Shutdown(ctx context.Context) error {
+	e.err = e.SpanExporter.Shutdown(ctx)
+	return e.err
+}
+
 // WithEnvGate causes a call to OtelSetup to be a no-op
 // if the environment variable defined by EnvGatename
 // is set to the value defined by EnvGateCue
@@ -78,15 +101,12 @@ func WithShutdownTimeout(t time.Duration) SetupOptionFunc {
 }
 
 // WithGeneralPropagatorSetup causes OtelSetup to configure
-// the default propagator with some basic propagators
+// the default propagator with some basic propagators. If the
+// OTEL_PROPAGATORS environment variable is set, it takes precedence over
+// the default, via the same name registry as WithEnvPropagators.
 func WithGeneralPropagatorSetup() SetupOptionFunc {
-	p := propagation.NewCompositeTextMapPropagator(
-		propagation.Baggage{},
-		propagation.TraceContext{},
-		UberTraceContext{},
-	)
 	return SetupOptionFunc(func(opts *setupConfig) {
-		opts.propagator = p
+		opts.propagator = envOrDefaultPropagator(opts.logger, defaultPropagator())
 	})
 }
 
@@ -107,6 +127,18 @@ func WithSampler(s trace.Sampler) SetupOptionFunc {
 	})
 }
 
+// WithExporter causes OtelSetup to fan spans out to e via its own
+// BatchSpanProcessor, alongside any other exporter configured with this or
+// another WithExporter* option. Passing more than one WithExporter* option
+// configures multiple exporters side by side rather than replacing one
+// another.
+func WithExporter(e trace.SpanExporter) SetupOptionFunc {
+	return SetupOptionFunc(func(opts *setupConfig) {
+		opts.exporters = append(opts.exporters, e)
+		opts.exportersSet = true
+	})
+}
+
 // WithOtlpExporter causes OtelSetup to configure an
 // exporter targeting the exporter otlp endpoint
 func WithOtlpExporter() SetupOptionFunc {
@@ -116,10 +148,87 @@ func WithOtlpExporter() SetupOptionFunc {
 			panic(fmt.Sprintf("cannot create otlp exporter: %s", err))
 		}
 
-		opts.exporter = exporter
+		WithExporter(exporter)(opts)
 	})
 }
 
+// WithOtlpHttpExporter causes OtelSetup to configure an exporter targeting
+// the otlp/http endpoint, passing options straight through to
+// otlptracehttp.New.
+func WithOtlpHttpExporter(options ...otlptracehttp.Option) SetupOptionFunc {
+	return SetupOptionFunc(func(opts *setupConfig) {
+		exporter, err := otlptracehttp.New(context.Background(), options...)
+		if err != nil {
+			panic(fmt.Sprintf("cannot create otlp http exporter: %s", err))
+		}
+
+		WithExporter(exporter)(opts)
+	})
+}
+
+// WithStdoutExporter causes OtelSetup to configure an exporter that writes
+// human-readable spans to w, useful for local debugging without a collector.
+func WithStdoutExporter(w io.Writer) SetupOptionFunc {
+	return SetupOptionFunc(func(opts *setupConfig) {
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			panic(fmt.Sprintf("cannot create stdout exporter: %s", err))
+		}
+
+		WithExporter(exporter)(opts)
+	})
+}
+
+// envExporters builds the exporters named by the comma-separated
+// EnvTracesExporter environment variable ("otlp", "otlp/http", "console",
+// "none"). Unknown names are logged and skipped. If the environment
+// variable is unset, or no valid names are found in it, fallback is
+// returned instead.
+func envExporters(logger *slog.Logger, fallback []trace.SpanExporter) []trace.SpanExporter {
+	raw := os.Getenv(EnvTracesExporter)
+	if raw == "" {
+		return fallback
+	}
+
+	var exporters []trace.SpanExporter
+	for _, name := range strings.Split(raw, ",") {
+		switch strings.TrimSpace(name) {
+		case "":
+			continue
+		case "none":
+			return nil
+		case "otlp":
+			exporter, err := otlptracegrpc.New(context.Background())
+			if err != nil {
+				logger.Error("cannot create otlp exporter", "error", err)
+				continue
+			}
+			exporters = append(exporters, exporter)
+		case "otlp/http":
+			exporter, err := otlptracehttp.New(context.Background())
+			if err != nil {
+				logger.Error("cannot create otlp/http exporter", "error", err)
+				continue
+			}
+			exporters = append(exporters, exporter)
+		case "console":
+			exporter, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+			if err != nil {
+				logger.Error("cannot create console exporter", "error", err)
+				continue
+			}
+			exporters = append(exporters, exporter)
+		default:
+			logger.Error("unknown exporter name in "+EnvTracesExporter, "name", strings.TrimSpace(name))
+		}
+	}
+
+	if len(exporters) == 0 {
+		return fallback
+	}
+	return exporters
+}
+
 // WithRemoteSampler causes OtelSetup to be configured
 // with a remote sampler URL constructed using the environment
 // variable defined by EnvSamplingUrl, falling back
@@ -157,6 +266,31 @@ func getIPAddress() (string, error) {
 	return "", fmt.Errorf("no IP address found")
 }
 
+// buildResource builds the Resource shared by OtelSetup and
+// OtelMetricsSetup (service.name, host.name, host.ip), so traces and
+// metrics emitted for name correlate with one another.
+func buildResource(ctx context.Context, name string, logger *slog.Logger) *resource.Resource {
+	attrs := []attribute.KeyValue{
+		semconv.ServiceNameKey.String(name),
+		semconv.TelemetrySDKNameKey.String("opentelemetry"),
+		semconv.TelemetrySDKVersionKey.String(otel.Version()),
+	}
+
+	if ip, err := getIPAddress(); err != nil {
+		logger.ErrorContext(ctx, "failed to find host IP address", "error", err)
+	} else {
+		attrs = append(attrs, semconv.HostIPKey.String(ip))
+	}
+
+	if host, err := os.Hostname(); err != nil {
+		logger.ErrorContext(ctx, "os.Hostname() failed", "error", err)
+	} else {
+		attrs = append(attrs, semconv.HostNameKey.String(host))
+	}
+
+	return resource.NewWithAttributes(semconv.SchemaURL, attrs...)
+}
+
 // OtelSetup returns a otel TracerProvider
 // and a closer function to shut down the provider.
 //
@@ -182,10 +316,9 @@ func OtelSetup(ctx context.Context, name string, with ...SetupOptionFunc) (
 
 	// Apply options and return an error if one panics
 	opts := &setupConfig{
-		name:     name,
-		sampler:  trace.ParentBased(trace.AlwaysSample()),
-		exporter: nullExporter{},
-		logger:   slog.Default(),
+		name:    name,
+		sampler: trace.ParentBased(trace.AlwaysSample()),
+		logger:  slog.Default(),
 	}
 	defer func() {
 		if r := recover(); r != nil {
@@ -200,33 +333,28 @@ func OtelSetup(ctx context.Context, name string, with ...SetupOptionFunc) (
 		return
 	}
 
-	if opts.propagator != nil {
-		otel.SetTextMapPropagator(opts.propagator)
+	if !opts.exportersSet {
+		opts.exporters = envExporters(opts.logger, []trace.SpanExporter{nullExporter{}})
 	}
 
-	attrs := []attribute.KeyValue{
-		semconv.ServiceNameKey.String(name),
-		semconv.TelemetrySDKNameKey.String("opentelemetry"),
-		semconv.TelemetrySDKVersionKey.String(otel.Version()),
+	if opts.propagator != nil {
+		otel.SetTextMapPropagator(opts.propagator)
 	}
 
-	if ip, err := getIPAddress(); err != nil {
-		opts.logger.ErrorContext(ctx, "failed to find host IP address", "error", err)
-	} else {
-		attrs = append(attrs, semconv.HostIPKey.String(ip))
+	// BatchSpanProcessor.Shutdown reports an exporter's Shutdown error to
+	// otel.Handle rather than returning it, so wrap each exporter to capture
+	// its own error for closer to surface below.
+	wrapped := make([]*shutdownErrExporter, len(opts.exporters))
+	tpOpts := []trace.TracerProviderOption{
+		trace.WithSampler(opts.sampler),
+		trace.WithResource(buildResource(ctx, name, opts.logger)),
 	}
-
-	if host, err := os.Hostname(); err != nil {
-		opts.logger.ErrorContext(ctx, "os.Hostname() failed", "error", err)
-	} else {
-		attrs = append(attrs, semconv.HostNameKey.String(host))
+	for i, exporter := range opts.exporters {
+		wrapped[i] = &shutdownErrExporter{SpanExporter: exporter}
+		tpOpts = append(tpOpts, trace.WithBatcher(wrapped[i]))
 	}
 
-	tp = trace.NewTracerProvider(
-		trace.WithBatcher(opts.exporter),
-		trace.WithSampler(opts.sampler),
-		trace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, attrs...)),
-	)
+	tp = trace.NewTracerProvider(tpOpts...)
 
 	closer = closerFunc(func() error {
 		var ctx context.Context
@@ -238,7 +366,18 @@ func OtelSetup(ctx context.Context, name string, with ...SetupOptionFunc) (
 		} else {
 			ctx = context.Background()
 		}
-		err := tp.Shutdown(ctx)
+
+		var errs []error
+		if err := tp.Shutdown(ctx); err != nil {
+			errs = append(errs, err)
+		}
+		for _, exporter := range wrapped {
+			if exporter.err != nil {
+				errs = append(errs, exporter.err)
+			}
+		}
+
+		err := errors.Join(errs...)
 		if err != nil {
 			opts.logger.ErrorContext(ctx, "otel shutdown error", "error", err)
 		}
@@ -0,0 +1,72 @@
+// Copyright (c) 2026 Arista Networks, Inc.  All rights reserved.
+// Arista Networks, Inc. Confidential and Proprietary.
+
+package otel
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"testing"
+
+	trace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestEnvExportersUnset(t *testing.T) {
+	t.Setenv(EnvTracesExporter, "")
+
+	fallback := []trace.SpanExporter{nullExporter{}}
+	exporters := envExporters(slog.Default(), fallback)
+	if len(exporters) != 1 || exporters[0] != trace.SpanExporter(nullExporter{}) {
+		t.Fatalf("expected fallback exporters when %s is unset", EnvTracesExporter)
+	}
+}
+
+func TestEnvExportersNone(t *testing.T) {
+	t.Setenv(EnvTracesExporter, "none")
+
+	exporters := envExporters(slog.Default(), []trace.SpanExporter{nullExporter{}})
+	if exporters != nil {
+		t.Fatalf("expected no exporters for %s=none, got %v", EnvTracesExporter, exporters)
+	}
+}
+
+func TestEnvExportersUnknownName(t *testing.T) {
+	t.Setenv(EnvTracesExporter, "bogus")
+
+	fallback := []trace.SpanExporter{nullExporter{}}
+	exporters := envExporters(slog.Default(), fallback)
+	if len(exporters) != 1 || exporters[0] != trace.SpanExporter(nullExporter{}) {
+		t.Fatalf("expected fallback exporters when %s names nothing valid", EnvTracesExporter)
+	}
+}
+
+type failingExporter struct {
+	nullExporter
+	shutdownErr error
+}
+
+func (f *failingExporter) Shutdown(ctx context.Context) error {
+	return f.shutdownErr
+}
+
+func TestOtelSetupCloserJoinsExporterShutdownErrors(t *testing.T) {
+	errA := errors.New("exporter a failed")
+	errB := errors.New("exporter b failed")
+
+	_, closer, err := OtelSetup(context.Background(), "test",
+		WithExporter(&failingExporter{shutdownErr: errA}),
+		WithExporter(&failingExporter{shutdownErr: errB}),
+	)
+	if err != nil {
+		t.Fatalf("OtelSetup: %s", err)
+	}
+
+	shutdownErr := closer()
+	if !errors.Is(shutdownErr, errA) {
+		t.Fatalf("expected joined error to contain errA, got %v", shutdownErr)
+	}
+	if !errors.Is(shutdownErr, errB) {
+		t.Fatalf("expected joined error to contain errB, got %v", shutdownErr)
+	}
+}